@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/runtime"
@@ -60,6 +61,7 @@ type ProviderData struct {
 	ServiceAccountCustomEndpoint    string
 	EnableBetaResources             bool
 	Experiments                     []string
+	HTTPTimeout                     time.Duration // timeout of the HTTP client used by the API clients, zero means no timeout
 
 	Version string // version of the STACKIT Terraform provider
 }
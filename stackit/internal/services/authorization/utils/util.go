@@ -15,6 +15,7 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 	apiClientConfigOptions := []config.ConfigurationOption{
 		config.WithCustomAuth(providerData.RoundTripper),
 		utils.UserAgentConfigOption(providerData.Version),
+		utils.HTTPTimeoutConfigOption(providerData.HTTPTimeout),
 	}
 	if providerData.AuthorizationCustomEndpoint != "" {
 		apiClientConfigOptions = append(apiClientConfigOptions, config.WithEndpoint(providerData.AuthorizationCustomEndpoint))
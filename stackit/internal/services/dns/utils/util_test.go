@@ -5,6 +5,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	sdkClients "github.com/stackitcloud/stackit-sdk-go/core/clients"
@@ -17,6 +18,7 @@ import (
 const (
 	testVersion        = "1.2.3"
 	testCustomEndpoint = "https://dns-custom-endpoint.api.stackit.cloud"
+	testHTTPTimeout    = 5 * time.Minute
 )
 
 func TestConfigureClient(t *testing.T) {
@@ -74,6 +76,26 @@ func TestConfigureClient(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "custom http timeout",
+			args: args{
+				providerData: &core.ProviderData{
+					Version:     testVersion,
+					HTTPTimeout: testHTTPTimeout,
+				},
+			},
+			expected: func() *dns.APIClient {
+				apiClient, err := dns.NewAPIClient(
+					utils.UserAgentConfigOption(testVersion),
+					utils.HTTPTimeoutConfigOption(testHTTPTimeout),
+				)
+				if err != nil {
+					t.Errorf("error configuring client: %v", err)
+				}
+				return apiClient
+			}(),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -88,6 +110,10 @@ func TestConfigureClient(t *testing.T) {
 			if !reflect.DeepEqual(actual, tt.expected) {
 				t.Errorf("ConfigureClient() = %v, want %v", actual, tt.expected)
 			}
+
+			if actual != nil && actual.GetConfig().HTTPClient.Timeout != tt.args.providerData.HTTPTimeout {
+				t.Errorf("ConfigureClient() HTTP timeout = %v, want %v", actual.GetConfig().HTTPClient.Timeout, tt.args.providerData.HTTPTimeout)
+			}
 		})
 	}
 }
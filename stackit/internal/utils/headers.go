@@ -2,10 +2,60 @@ package utils
 
 import (
 	"fmt"
+	"net/http"
+	"runtime"
+	"time"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 )
 
+// UserAgentConfigOption returns a ConfigurationOption setting the User-Agent to the provider version,
+// followed by the Go version and platform the provider was built for, e.g. "stackit-terraform-provider/1.0.0 (go1.24.0; linux/amd64)"
 func UserAgentConfigOption(providerVersion string) config.ConfigurationOption {
-	return config.WithUserAgent(fmt.Sprintf("stackit-terraform-provider/%s", providerVersion))
+	return config.WithUserAgent(fmt.Sprintf("stackit-terraform-provider/%s (%s; %s/%s)", providerVersion, runtime.Version(), runtime.GOOS, runtime.GOARCH))
+}
+
+// HTTPTimeoutConfigOption returns a ConfigurationOption setting the timeout of the API client's HTTP client.
+// A zero timeout leaves the configuration untouched, so requests are only bound by their context
+func HTTPTimeoutConfigOption(timeout time.Duration) config.ConfigurationOption {
+	return func(cfg *config.Configuration) error {
+		if timeout == 0 {
+			return nil
+		}
+		// config.WithTimeout expects the HTTP client to be set, which the SDK only does after applying the options
+		if cfg.HTTPClient == nil {
+			cfg.HTTPClient = &http.Client{}
+		}
+		return config.WithTimeout(timeout)(cfg)
+	}
+}
+
+// TerraformRunIdHeader is the header used to correlate API requests with the Terraform run that made them
+const TerraformRunIdHeader = "X-Terraform-Run-Id"
+
+// RunIdRoundTripper wraps next so that requests carry the given Terraform run ID in the X-Terraform-Run-Id header.
+// Requests which already set the header are passed on unchanged. If runId is empty, next is returned as is
+func RunIdRoundTripper(next http.RoundTripper, runId string) http.RoundTripper {
+	if runId == "" {
+		return next
+	}
+	return &runIdRoundTripper{
+		next:  next,
+		runId: runId,
+	}
+}
+
+type runIdRoundTripper struct {
+	next  http.RoundTripper
+	runId string
+}
+
+func (rt *runIdRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(TerraformRunIdHeader) != "" {
+		return rt.next.RoundTrip(req)
+	}
+	// A RoundTripper must not modify the request it was given
+	req = req.Clone(req.Context())
+	req.Header.Set(TerraformRunIdHeader, rt.runId)
+	return rt.next.RoundTrip(req)
 }
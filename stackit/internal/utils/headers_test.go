@@ -1,8 +1,13 @@
 package utils
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
 )
@@ -21,7 +26,7 @@ func TestUserAgentConfigOption(t *testing.T) {
 			args: args{
 				providerVersion: "1.0.0",
 			},
-			want: config.WithUserAgent("stackit-terraform-provider/1.0.0"),
+			want: config.WithUserAgent(fmt.Sprintf("stackit-terraform-provider/1.0.0 (%s; %s/%s)", runtime.Version(), runtime.GOOS, runtime.GOARCH)),
 		},
 	}
 	for _, tt := range tests {
@@ -44,3 +49,111 @@ func TestUserAgentConfigOption(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPTimeoutConfigOption(t *testing.T) {
+	tests := []struct {
+		description string
+		timeout     time.Duration
+		input       config.Configuration
+		expected    config.Configuration
+	}{
+		{
+			"no timeout",
+			0,
+			config.Configuration{},
+			config.Configuration{},
+		},
+		{
+			"timeout without http client",
+			time.Minute,
+			config.Configuration{},
+			config.Configuration{
+				HTTPClient: &http.Client{Timeout: time.Minute},
+			},
+		},
+		{
+			"timeout with http client",
+			time.Minute,
+			config.Configuration{
+				HTTPClient: &http.Client{Timeout: time.Second},
+			},
+			config.Configuration{
+				HTTPClient: &http.Client{Timeout: time.Minute},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := HTTPTimeoutConfigOption(tt.timeout)(&tt.input)
+			if err != nil {
+				t.Fatalf("error applying configuration: %v", err)
+			}
+			if !reflect.DeepEqual(tt.input, tt.expected) {
+				t.Fatalf("HTTPTimeoutConfigOption() = %v, want %v", tt.input, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunIdRoundTripper(t *testing.T) {
+	tests := []struct {
+		description   string
+		runId         string
+		requestHeader string
+		expected      string
+	}{
+		{
+			"run id attached",
+			"run-123",
+			"",
+			"run-123",
+		},
+		{
+			"run id omitted",
+			"",
+			"",
+			"",
+		},
+		{
+			"header set by the sdk is kept",
+			"run-123",
+			"run-456",
+			"run-456",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var received []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r.Header.Values(TerraformRunIdHeader)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(http.MethodGet, server.URL, http.NoBody)
+			if err != nil {
+				t.Fatalf("Creating request: %v", err)
+			}
+			if tt.requestHeader != "" {
+				req.Header.Set(TerraformRunIdHeader, tt.requestHeader)
+			}
+
+			resp, err := RunIdRoundTripper(http.DefaultTransport, tt.runId).RoundTrip(req)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if tt.expected == "" {
+				if len(received) != 0 {
+					t.Fatalf("Expected no %s header, got %v", TerraformRunIdHeader, received)
+				}
+			} else if len(received) != 1 || received[0] != tt.expected {
+				t.Fatalf("Expected %s header %q, got %v", TerraformRunIdHeader, tt.expected, received)
+			}
+			if req.Header.Get(TerraformRunIdHeader) != tt.requestHeader {
+				t.Fatalf("Original request was modified")
+			}
+		})
+	}
+}
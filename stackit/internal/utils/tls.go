@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+)
+
+// MinTLSVersionEnvVar is the environment variable used to raise the minimum TLS version of the provider's HTTP traffic
+const MinTLSVersionEnvVar = "STACKIT_MIN_TLS"
+
+// ParseMinTLSVersion converts a minimum TLS version as set in STACKIT_MIN_TLS into its crypto/tls constant.
+// An empty value defaults to TLS 1.2
+func ParseMinTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("invalid minimum TLS version %q, supported values are \"1.2\" and \"1.3\"", version)
+	}
+}
+
+// NewHTTPClientWithMinTLSVersion returns an HTTP client based on a copy of http.DefaultTransport
+// which refuses connections negotiating a TLS version lower than minVersion
+func NewHTTPClientWithMinTLSVersion(minVersion uint16) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: minVersion}
+	} else {
+		transport.TLSClientConfig.MinVersion = minVersion
+	}
+	return &http.Client{Transport: transport}
+}
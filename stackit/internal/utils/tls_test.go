@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseMinTLSVersion(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    uint16
+		isValid     bool
+	}{
+		{
+			"default",
+			"",
+			tls.VersionTLS12,
+			true,
+		},
+		{
+			"tls 1.2",
+			"1.2",
+			tls.VersionTLS12,
+			true,
+		},
+		{
+			"tls 1.3",
+			"1.3",
+			tls.VersionTLS13,
+			true,
+		},
+		{
+			"tls 1.1",
+			"1.1",
+			0,
+			false,
+		},
+		{
+			"invalid",
+			"foo",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := ParseMinTLSVersion(tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if output != tt.expected {
+				t.Fatalf("Expected %d, got %d", tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientWithMinTLSVersion(t *testing.T) {
+	tests := []struct {
+		description      string
+		clientMinVersion string
+		serverMinVersion uint16
+		serverMaxVersion uint16
+		isValid          bool
+	}{
+		{
+			"default rejects tls 1.1 server",
+			"",
+			tls.VersionTLS10,
+			tls.VersionTLS11,
+			false,
+		},
+		{
+			"default accepts tls 1.2 server",
+			"",
+			tls.VersionTLS12,
+			tls.VersionTLS12,
+			true,
+		},
+		{
+			"tls 1.3 rejects tls 1.2 server",
+			"1.3",
+			tls.VersionTLS12,
+			tls.VersionTLS12,
+			false,
+		},
+		{
+			"tls 1.3 accepts tls 1.3 server",
+			"1.3",
+			tls.VersionTLS13,
+			tls.VersionTLS13,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			server.TLS = &tls.Config{
+				MinVersion: tt.serverMinVersion,
+				MaxVersion: tt.serverMaxVersion,
+			}
+			server.StartTLS()
+			defer server.Close()
+
+			minVersion, err := ParseMinTLSVersion(tt.clientMinVersion)
+			if err != nil {
+				t.Fatalf("Parsing minimum TLS version: %v", err)
+			}
+			client := NewHTTPClientWithMinTLSVersion(minVersion)
+			certPool := x509.NewCertPool()
+			certPool.AddCert(server.Certificate())
+			client.Transport.(*http.Transport).TLSClientConfig.RootCAs = certPool
+
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				defer resp.Body.Close()
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
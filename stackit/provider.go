@@ -3,7 +3,9 @@ package stackit
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -93,6 +95,8 @@ import (
 	skeKubeconfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/kubeconfig"
 	sqlServerFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/instance"
 	sqlServerFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/user"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -160,8 +164,10 @@ type providerModel struct {
 	SqlServerFlexCustomEndpoint     types.String `tfsdk:"sqlserverflex_custom_endpoint"`
 	TokenCustomEndpoint             types.String `tfsdk:"token_custom_endpoint"`
 
-	EnableBetaResources types.Bool `tfsdk:"enable_beta_resources"`
-	Experiments         types.List `tfsdk:"experiments"`
+	EnableBetaResources types.Bool   `tfsdk:"enable_beta_resources"`
+	Experiments         types.List   `tfsdk:"experiments"`
+	HTTPTimeout         types.String `tfsdk:"http_timeout"`
+	TerraformRunId      types.String `tfsdk:"terraform_run_id"`
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -204,6 +210,8 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 		"service_enablement_custom_endpoint": "Custom endpoint for the Service Enablement API",
 		"token_custom_endpoint":              "Custom endpoint for the token API, which is used to request access tokens when using the key flow",
 		"enable_beta_resources":              "Enable beta resources. Default is false.",
+		"http_timeout":                       "Timeout of the HTTP client used for the API requests of all resources and data sources, as a positive duration such as `30s` or `5m`. Takes precedence over the env var `STACKIT_HTTP_TIMEOUT`. By default, no client timeout is set.",
+		"terraform_run_id":                   "ID of the Terraform run, sent in the `X-Terraform-Run-Id` header of all API requests to correlate them with STACKIT audit logs. Takes precedence over the env var `TFC_RUN_ID`, which is set in HCP Terraform runs. If neither is set, the header is omitted.",
 		"experiments":                        fmt.Sprintf("Enables experiments. These are unstable features without official support. More information can be found in the README. Available Experiments: %v", strings.Join(features.AvailableExperiments, ", ")),
 	}
 
@@ -265,6 +273,17 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:    true,
 				Description: descriptions["experiments"],
 			},
+			"http_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["http_timeout"],
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+			},
+			"terraform_run_id": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["terraform_run_id"],
+			},
 			// Custom endpoints
 			"cdn_custom_endpoint": schema.StringAttribute{
 				Optional:    true,
@@ -460,15 +479,37 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		providerData.Experiments = experimentValues
 	}
 
+	httpTimeout := os.Getenv("STACKIT_HTTP_TIMEOUT")
+	setStringField(providerConfig.HTTPTimeout, func(v string) { httpTimeout = v })
+	if httpTimeout != "" {
+		timeout, err := time.ParseDuration(httpTimeout)
+		if err != nil || timeout <= 0 {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up HTTP timeout: %q is not a positive duration", httpTimeout))
+			return
+		}
+		providerData.HTTPTimeout = timeout
+	}
+
+	// Enforce a minimum TLS version on the transport used by the authentication flows and all API calls
+	minTLSVersion, err := utils.ParseMinTLSVersion(os.Getenv(utils.MinTLSVersionEnvVar))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up minimum TLS version: %v", err))
+		return
+	}
+	sdkConfig.HTTPClient = utils.NewHTTPClientWithMinTLSVersion(minTLSVersion)
+
 	roundTripper, err := sdkauth.SetupAuth(sdkConfig)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up authentication: %v", err))
 		return
 	}
 
+	runId := os.Getenv("TFC_RUN_ID")
+	setStringField(providerConfig.TerraformRunId, func(v string) { runId = v })
+
 	// Make round tripper and custom endpoints available during DataSource and Resource
 	// type Configure methods.
-	providerData.RoundTripper = roundTripper
+	providerData.RoundTripper = utils.RunIdRoundTripper(roundTripper, runId)
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 
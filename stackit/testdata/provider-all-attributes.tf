@@ -33,6 +33,7 @@ provider "stackit" {
   service_enablement_custom_endpoint = "https://service-enablement.api.stackit.cloud"
   token_custom_endpoint              = "https://token.api.stackit.cloud"
   enable_beta_resources              = "true"
+  http_timeout                       = "5m"
 }
 
 resource "stackit_network" "network" {